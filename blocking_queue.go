@@ -0,0 +1,112 @@
+package kewpie
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrClosed is returned by BlockingQueue.Dequeue and BlockingQueue.TryDequeue
+// once the queue has been closed and fully drained.
+var ErrClosed = errors.New("kewpie: queue is closed")
+
+// BlockingQueue is a concurrency-safe FIFO queue for elements of type T. It
+// wraps a Queue[T] with a mutex and a condition variable so that producers
+// and consumers can hand off items across goroutines, making it suitable as
+// a building block for worker pools and producer/consumer pipelines.
+type BlockingQueue[T any] struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	q      *Queue[T]
+	closed bool
+}
+
+// NewBlockingQueue creates a new BlockingQueue for elements of type T with an
+// initial capacity.
+func NewBlockingQueue[T any](sizes ...int) *BlockingQueue[T] {
+	bq := &BlockingQueue[T]{q: NewQueue[T](sizes...)}
+	bq.cond = sync.NewCond(&bq.mu)
+	return bq
+}
+
+// Enqueue adds an element of type T to the end of the queue and wakes one
+// waiting consumer, if any.
+func (bq *BlockingQueue[T]) Enqueue(data T) {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	bq.q.Enqueue(data)
+	bq.cond.Signal()
+}
+
+// Dequeue removes and returns the element at the front of the queue,
+// blocking until an item is available or the queue is closed. Once the
+// queue is closed and drained, Dequeue returns ErrClosed.
+func (bq *BlockingQueue[T]) Dequeue() (T, error) {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	for bq.q.Size() == 0 && !bq.closed {
+		bq.cond.Wait()
+	}
+
+	if bq.q.Size() == 0 {
+		var zero T
+		return zero, ErrClosed
+	}
+
+	return bq.q.Dequeue()
+}
+
+// TryDequeue removes and returns the element at the front of the queue
+// without blocking. It returns an error if the queue is currently empty,
+// or ErrClosed if the queue has been closed and drained.
+func (bq *BlockingQueue[T]) TryDequeue() (T, error) {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	if bq.q.Size() == 0 {
+		var zero T
+		if bq.closed {
+			return zero, ErrClosed
+		}
+		return zero, errors.New("kewpie: queue is empty")
+	}
+
+	return bq.q.Dequeue()
+}
+
+// DequeueBatch blocks until at least one item is available (or the queue is
+// closed), then returns up to batchSize items without blocking further.
+func (bq *BlockingQueue[T]) DequeueBatch(batchSize int) ([]T, error) {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	for bq.q.Size() == 0 && !bq.closed {
+		bq.cond.Wait()
+	}
+
+	if bq.q.Size() == 0 {
+		return nil, ErrClosed
+	}
+
+	return bq.q.DequeueBatch(batchSize)
+}
+
+// Close marks the queue as closed and wakes all waiting consumers. Items
+// already in the queue can still be drained via Dequeue/TryDequeue/
+// DequeueBatch; once empty, those methods return ErrClosed.
+func (bq *BlockingQueue[T]) Close() {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	bq.closed = true
+	bq.cond.Broadcast()
+}
+
+// Size returns the queue's current size.
+func (bq *BlockingQueue[T]) Size() int {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	return bq.q.Size()
+}
@@ -0,0 +1,90 @@
+package kewpie_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mips171/kewpie"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockingQueueEnqueueDequeue(t *testing.T) {
+	bq := kewpie.NewBlockingQueue[int]()
+	bq.Enqueue(1)
+	bq.Enqueue(2)
+
+	val, err := bq.Dequeue()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, val)
+
+	val, err = bq.Dequeue()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, val)
+}
+
+func TestBlockingQueueTryDequeueEmpty(t *testing.T) {
+	bq := kewpie.NewBlockingQueue[int]()
+
+	_, err := bq.TryDequeue()
+	assert.Error(t, err, "queue is empty")
+}
+
+func TestBlockingQueueDequeueBlocksUntilEnqueue(t *testing.T) {
+	bq := kewpie.NewBlockingQueue[int]()
+
+	done := make(chan int)
+	go func() {
+		val, err := bq.Dequeue()
+		assert.NoError(t, err)
+		done <- val
+	}()
+
+	time.Sleep(10 * time.Millisecond) // give the goroutine a chance to start waiting
+	bq.Enqueue(42)
+
+	select {
+	case val := <-done:
+		assert.Equal(t, 42, val)
+	case <-time.After(time.Second):
+		t.Fatal("Dequeue did not return after Enqueue")
+	}
+}
+
+func TestBlockingQueueCloseDrainsThenReturnsErrClosed(t *testing.T) {
+	bq := kewpie.NewBlockingQueue[int]()
+	bq.Enqueue(1)
+	bq.Enqueue(2)
+	bq.Close()
+
+	val, err := bq.Dequeue()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, val)
+
+	val, err = bq.Dequeue()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, val)
+
+	_, err = bq.Dequeue()
+	assert.ErrorIs(t, err, kewpie.ErrClosed)
+}
+
+func TestBlockingQueueCloseWakesWaiters(t *testing.T) {
+	bq := kewpie.NewBlockingQueue[int]()
+
+	done := make(chan error)
+	go func() {
+		_, err := bq.Dequeue()
+		done <- err
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	bq.Close()
+
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, kewpie.ErrClosed)
+	case <-time.After(time.Second):
+		t.Fatal("Dequeue did not wake up after Close")
+	}
+}
@@ -0,0 +1,248 @@
+package kewpie
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrFull is returned by BoundedQueue.Enqueue under PolicyReject once the
+// queue is at its hard capacity limit.
+var ErrFull = errors.New("kewpie: queue is full")
+
+// Policy controls what BoundedQueue.Enqueue does once the queue is at its
+// hard capacity limit.
+type Policy int
+
+const (
+	// PolicyBlock makes Enqueue block until space becomes available.
+	PolicyBlock Policy = iota
+	// PolicyDropOldest evicts the item at the front of the queue to make
+	// room for the new one.
+	PolicyDropOldest
+	// PolicyDropNewest silently discards the item being enqueued.
+	PolicyDropNewest
+	// PolicyReject makes Enqueue return ErrFull without modifying the
+	// queue.
+	PolicyReject
+)
+
+// defaultSoftLimitPercent is the fraction of hardMax at which BoundedQueue
+// switches to arithmetic growth and starts warning via onSoftLimit.
+const defaultSoftLimitPercent = 0.8
+
+// arithmeticGrowthStep is the fixed number of slots BoundedQueue grows by
+// once it has crossed its soft limit, replacing the geometric doubling
+// Queue.Enqueue normally uses.
+const arithmeticGrowthStep = 64
+
+// QueueStats reports a BoundedQueue's current occupancy and lifetime
+// throughput, so callers can observe backpressure.
+type QueueStats struct {
+	Size          int
+	Capacity      int
+	HighWaterMark int
+	TotalEnqueued uint64
+	TotalDequeued uint64
+}
+
+// Option configures a BoundedQueue at construction time.
+type Option[T any] func(*BoundedQueue[T])
+
+// WithSoftLimitPercent sets the fraction of hardMax (0 < pct <= 1) at which
+// the queue switches to arithmetic growth and calls the soft-limit
+// callback. The default is 0.8.
+func WithSoftLimitPercent[T any](pct float64) Option[T] {
+	return func(bq *BoundedQueue[T]) {
+		bq.softLimitPercent = pct
+	}
+}
+
+// WithPolicy sets the backpressure policy applied once the queue is at its
+// hard capacity limit. The default is PolicyReject.
+func WithPolicy[T any](p Policy) Option[T] {
+	return func(bq *BoundedQueue[T]) {
+		bq.policy = p
+	}
+}
+
+// WithSoftLimitCallback registers fn to be called with the queue's current
+// stats after every Enqueue that leaves the queue at or above the soft
+// limit.
+func WithSoftLimitCallback[T any](fn func(stats QueueStats)) Option[T] {
+	return func(bq *BoundedQueue[T]) {
+		bq.onSoftLimit = fn
+	}
+}
+
+// BoundedQueue wraps a Queue[T] with a hard capacity limit, a soft-limit
+// warning threshold, and a configurable backpressure policy for what
+// happens once the hard limit is reached. It is safe for concurrent use.
+type BoundedQueue[T any] struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+	q    *Queue[T]
+
+	hardMax          int
+	softLimitPercent float64
+	policy           Policy
+	onSoftLimit      func(stats QueueStats)
+
+	highWaterMark int
+	enqueued      uint64
+	dequeued      uint64
+}
+
+// NewBoundedQueue creates a new BoundedQueue for elements of type T with the
+// given hard capacity limit.
+func NewBoundedQueue[T any](hardMax int, opts ...Option[T]) *BoundedQueue[T] {
+	if hardMax <= 0 {
+		hardMax = 1
+	}
+
+	bq := &BoundedQueue[T]{
+		q:                NewQueue[T](),
+		hardMax:          hardMax,
+		softLimitPercent: defaultSoftLimitPercent,
+		policy:           PolicyReject,
+	}
+	bq.cond = sync.NewCond(&bq.mu)
+
+	for _, opt := range opts {
+		opt(bq)
+	}
+
+	return bq
+}
+
+// softLimit returns the absolute item count at which the queue is
+// considered to have crossed its soft limit.
+func (bq *BoundedQueue[T]) softLimit() int {
+	return int(float64(bq.hardMax) * bq.softLimitPercent)
+}
+
+// Enqueue adds an element of type T to the end of the queue. Once the queue
+// is at its hard capacity limit, behavior is determined by the configured
+// Policy: PolicyBlock waits for room, PolicyDropOldest silently evicts the
+// front item to make room, PolicyDropNewest silently discards data, and
+// PolicyReject returns ErrFull.
+func (bq *BoundedQueue[T]) Enqueue(data T) error {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	for {
+		if bq.q.Size() < bq.hardMax && bq.q.Size() < len(bq.q.data) {
+			break // there's already room in the backing array
+		}
+
+		if bq.q.Size() < bq.hardMax {
+			// Under the hard limit but the backing array is full: try to
+			// grow it. If that succeeds there's room now.
+			if bq.growForInsert() {
+				break
+			}
+			// Growth failed (e.g. a recovered panic from make, out of
+			// memory): fall through and treat this exactly like being at
+			// the hard limit, applying the configured policy below.
+		}
+
+		switch bq.policy {
+		case PolicyBlock:
+			bq.cond.Wait()
+		case PolicyDropOldest:
+			bq.q.Dequeue() // make room for the new item
+		case PolicyDropNewest:
+			return nil
+		default:
+			return ErrFull
+		}
+	}
+
+	bq.q.Enqueue(data)
+	bq.enqueued++
+
+	if size := bq.q.Size(); size > bq.highWaterMark {
+		bq.highWaterMark = size
+	}
+
+	if bq.onSoftLimit != nil && bq.q.Size() >= bq.softLimit() {
+		bq.onSoftLimit(bq.statsLocked())
+	}
+
+	bq.cond.Signal()
+	return nil
+}
+
+// growForInsert grows the underlying queue's capacity ahead of an insert,
+// using arithmetic growth once past the soft limit (to avoid runaway
+// allocation) and geometric growth below it, capped at hardMax. It reports
+// whether the backing array actually grew: Queue.resize recovers from a
+// panic in make (e.g. out of memory) by silently leaving the array
+// unchanged, so the only way to detect that failure is to compare lengths
+// before and after.
+//
+// growForInsert is only called when the queue is full (queue.size ==
+// len(queue.data)), so queue.size doubles as the pre-grow capacity here.
+func (bq *BoundedQueue[T]) growForInsert() bool {
+	current := bq.q.size
+	var next int
+	if bq.q.Size() >= bq.softLimit() {
+		next = current + arithmeticGrowthStep
+	} else {
+		next = current * 2
+	}
+	if next > bq.hardMax {
+		next = bq.hardMax
+	}
+	bq.q.resize(next)
+	return len(bq.q.data) > current
+}
+
+// Dequeue removes and returns the element at the front of the queue. It
+// returns an error if the queue is empty.
+func (bq *BoundedQueue[T]) Dequeue() (T, error) {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	val, err := bq.q.Dequeue()
+	if err == nil {
+		bq.dequeued++
+		bq.cond.Signal()
+	}
+	return val, err
+}
+
+// Peek returns the element at the front of the queue without removing it.
+// It returns an error if the queue is empty.
+func (bq *BoundedQueue[T]) Peek() (T, error) {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	return bq.q.Peek()
+}
+
+// Size returns the queue's current size.
+func (bq *BoundedQueue[T]) Size() int {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	return bq.q.Size()
+}
+
+// Stats returns the queue's current size, capacity, high-water mark, and
+// lifetime enqueue/dequeue counts.
+func (bq *BoundedQueue[T]) Stats() QueueStats {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	return bq.statsLocked()
+}
+
+func (bq *BoundedQueue[T]) statsLocked() QueueStats {
+	return QueueStats{
+		Size:          bq.q.Size(),
+		Capacity:      len(bq.q.data),
+		HighWaterMark: bq.highWaterMark,
+		TotalEnqueued: bq.enqueued,
+		TotalDequeued: bq.dequeued,
+	}
+}
@@ -0,0 +1,34 @@
+package kewpie
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBoundedQueueAppliesPolicyWhenGrowFails exercises the branch where
+// growForInsert's underlying Queue.resize recovers from a failed
+// allocation (e.g. out of memory) instead of actually growing. We force
+// that failure deterministically and without allocating any real memory by
+// seeding queue.size with a value whose doubling overflows int: resize then
+// falls back to a capacity of queue.size itself, far too many elements for
+// make(), which rejects it immediately.
+func TestBoundedQueueAppliesPolicyWhenGrowFails(t *testing.T) {
+	bq := NewBoundedQueue[int](math.MaxInt, WithPolicy[int](PolicyReject))
+	bq.q.size = math.MaxInt/2 + 1
+
+	err := bq.Enqueue(1)
+	assert.ErrorIs(t, err, ErrFull)
+	assert.Equal(t, math.MaxInt/2+1, bq.q.size, "a failed grow must not mutate queue state")
+	assert.Equal(t, uint64(0), bq.enqueued)
+}
+
+func TestBoundedQueueDropNewestWhenGrowFails(t *testing.T) {
+	bq := NewBoundedQueue[int](math.MaxInt, WithPolicy[int](PolicyDropNewest))
+	bq.q.size = math.MaxInt/2 + 1
+
+	err := bq.Enqueue(1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), bq.enqueued, "the item must be silently dropped, not inserted")
+}
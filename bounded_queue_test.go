@@ -0,0 +1,83 @@
+package kewpie_test
+
+import (
+	"testing"
+
+	"github.com/mips171/kewpie"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoundedQueueRejectsWhenFull(t *testing.T) {
+	bq := kewpie.NewBoundedQueue[int](2, kewpie.WithPolicy[int](kewpie.PolicyReject))
+
+	assert.NoError(t, bq.Enqueue(1))
+	assert.NoError(t, bq.Enqueue(2))
+
+	err := bq.Enqueue(3)
+	assert.ErrorIs(t, err, kewpie.ErrFull)
+	assert.Equal(t, 2, bq.Size())
+}
+
+func TestBoundedQueueDropOldest(t *testing.T) {
+	bq := kewpie.NewBoundedQueue[int](2, kewpie.WithPolicy[int](kewpie.PolicyDropOldest))
+
+	assert.NoError(t, bq.Enqueue(1))
+	assert.NoError(t, bq.Enqueue(2))
+	assert.NoError(t, bq.Enqueue(3))
+
+	val, err := bq.Dequeue()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, val)
+
+	val, err = bq.Dequeue()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, val)
+}
+
+func TestBoundedQueueDropNewest(t *testing.T) {
+	bq := kewpie.NewBoundedQueue[int](2, kewpie.WithPolicy[int](kewpie.PolicyDropNewest))
+
+	assert.NoError(t, bq.Enqueue(1))
+	assert.NoError(t, bq.Enqueue(2))
+	assert.NoError(t, bq.Enqueue(3))
+
+	val, err := bq.Dequeue()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, val)
+
+	val, err = bq.Dequeue()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, val)
+}
+
+func TestBoundedQueueSoftLimitCallback(t *testing.T) {
+	var warned []kewpie.QueueStats
+	bq := kewpie.NewBoundedQueue[int](10,
+		kewpie.WithSoftLimitPercent[int](0.5),
+		kewpie.WithSoftLimitCallback[int](func(stats kewpie.QueueStats) {
+			warned = append(warned, stats)
+		}),
+	)
+
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, bq.Enqueue(i))
+	}
+
+	assert.NotEmpty(t, warned)
+	assert.Equal(t, 5, warned[0].Size)
+}
+
+func TestBoundedQueueStats(t *testing.T) {
+	bq := kewpie.NewBoundedQueue[int](10)
+
+	bq.Enqueue(1)
+	bq.Enqueue(2)
+	bq.Dequeue()
+
+	stats := bq.Stats()
+	assert.Equal(t, 1, stats.Size)
+	assert.Equal(t, uint64(2), stats.TotalEnqueued)
+	assert.Equal(t, uint64(1), stats.TotalDequeued)
+	assert.Equal(t, 2, stats.HighWaterMark)
+}
@@ -0,0 +1,173 @@
+package kewpie
+
+import (
+	"errors"
+	"sync"
+)
+
+// defaultChunkSize is used by NewQueueWithChunkSize when given a
+// non-positive chunk size.
+const defaultChunkSize = 256
+
+// chunkNode is a fixed-size segment of a ChunkedQueue's backing linked list.
+// Items are read from data[start:end] and written at data[end], growing
+// until end reaches len(data).
+type chunkNode[T any] struct {
+	data       []T
+	start, end int
+	next       *chunkNode[T]
+}
+
+// ChunkedQueue is a generic FIFO queue backed by a linked list of fixed-size
+// chunks rather than a single contiguous ring buffer. Enqueue and Dequeue
+// operate on the tail/head chunk's local indices, so neither one ever
+// copies the whole queue the way Queue[T]'s resize does; only a single
+// chunk is allocated (or returned to a free-list) when it fills up or is
+// exhausted. This bounds worst-case per-op latency, at the cost of some
+// locality compared to a single contiguous buffer.
+type ChunkedQueue[T any] struct {
+	chunkSize int
+	head      *chunkNode[T]
+	tail      *chunkNode[T]
+	size      int
+	free      sync.Pool
+}
+
+// NewQueueWithChunkSize creates a new ChunkedQueue for elements of type T,
+// storing items in chunks of the given size.
+func NewQueueWithChunkSize[T any](chunkSize int) *ChunkedQueue[T] {
+	if chunkSize <= 0 {
+		chunkSize = defaultChunkSize
+	}
+
+	q := &ChunkedQueue[T]{chunkSize: chunkSize}
+	q.free.New = func() any {
+		return make([]T, q.chunkSize)
+	}
+
+	first := q.newChunk()
+	q.head = first
+	q.tail = first
+	return q
+}
+
+// newChunk returns a chunk from the free-list if one is available, or
+// allocates a new one otherwise.
+func (q *ChunkedQueue[T]) newChunk() *chunkNode[T] {
+	data, _ := q.free.Get().([]T)
+	if len(data) != q.chunkSize {
+		data = make([]T, q.chunkSize)
+	}
+	return &chunkNode[T]{data: data}
+}
+
+// Enqueue adds an element of type T to the end of the queue, allocating a
+// new tail chunk if the current one is full.
+func (q *ChunkedQueue[T]) Enqueue(data T) {
+	if q.tail.end == q.chunkSize {
+		q.growTail()
+	}
+	q.tail.data[q.tail.end] = data
+	q.tail.end++
+	q.size++
+}
+
+// EnqueueBatch adds multiple elements of type T to the end of the queue. If
+// the batch is larger than the chunk size, whole chunks are allocated and
+// filled directly rather than appending one item at a time.
+func (q *ChunkedQueue[T]) EnqueueBatch(items []T) {
+	for len(items) > 0 {
+		if q.tail.end == q.chunkSize {
+			q.growTail()
+		}
+
+		n := min(q.chunkSize-q.tail.end, len(items))
+		copy(q.tail.data[q.tail.end:], items[:n])
+		q.tail.end += n
+		q.size += n
+		items = items[n:]
+	}
+}
+
+// growTail appends a fresh chunk after the current tail and makes it the
+// new tail. If the chunk being grown past is also the head chunk and has
+// already been fully drained, it is recycled here too: Dequeue only
+// advances/recycles the head chunk when it has a next chunk to advance to,
+// so a chunk that is simultaneously head and tail never gets that chance on
+// its own.
+func (q *ChunkedQueue[T]) growTail() {
+	old := q.tail
+	next := q.newChunk()
+	old.next = next
+	q.tail = next
+
+	if q.head == old && old.start == q.chunkSize {
+		q.head = next
+		old.next = nil
+		old.start, old.end = 0, 0
+		q.free.Put(old.data)
+	}
+}
+
+// Dequeue removes and returns the element at the front of the queue. It
+// returns an error if the queue is empty.
+func (q *ChunkedQueue[T]) Dequeue() (T, error) {
+	if q.size == 0 {
+		var zero T
+		return zero, errors.New("kewpie: queue is empty")
+	}
+
+	element := q.head.data[q.head.start]
+	var zero T
+	q.head.data[q.head.start] = zero // Clearing the reference to avoid memory leak from stale struct
+	q.head.start++
+	q.size--
+
+	if q.head.start == q.chunkSize && q.head.next != nil {
+		exhausted := q.head
+		q.head = q.head.next
+		exhausted.next = nil
+		exhausted.start, exhausted.end = 0, 0
+		q.free.Put(exhausted.data)
+	}
+
+	return element, nil
+}
+
+// DequeueBatch dequeues elements up to the specified batchSize.
+func (q *ChunkedQueue[T]) DequeueBatch(batchSize int) ([]T, error) {
+	var batch []T
+	for i := 0; i < batchSize; i++ {
+		if q.size == 0 {
+			break
+		}
+		item, err := q.Dequeue()
+		if err != nil {
+			return nil, err
+		}
+		batch = append(batch, item)
+	}
+	return batch, nil
+}
+
+// Peek returns the element at the front of the queue without removing it.
+// It returns an error if the queue is empty.
+func (q *ChunkedQueue[T]) Peek() (T, error) {
+	if q.size == 0 {
+		var zero T
+		return zero, errors.New("kewpie: queue is empty")
+	}
+	return q.head.data[q.head.start], nil
+}
+
+// Size returns the queue's size.
+func (q *ChunkedQueue[T]) Size() int {
+	return q.size
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
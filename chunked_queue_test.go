@@ -0,0 +1,79 @@
+package kewpie_test
+
+import (
+	"testing"
+
+	"github.com/mips171/kewpie"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunkedQueueEnqueueDequeue(t *testing.T) {
+	q := kewpie.NewQueueWithChunkSize[int](4)
+	for i := 0; i < 10; i++ {
+		q.Enqueue(i)
+	}
+	assert.Equal(t, 10, q.Size())
+
+	for i := 0; i < 10; i++ {
+		val, err := q.Dequeue()
+		assert.NoError(t, err)
+		assert.Equal(t, i, val)
+	}
+
+	_, err := q.Dequeue()
+	assert.Error(t, err, "queue is empty")
+}
+
+func TestChunkedQueueAcrossManyChunkBoundaries(t *testing.T) {
+	q := kewpie.NewQueueWithChunkSize[int](3)
+
+	// Repeatedly fill past several chunk boundaries and drain again, so the
+	// head chunk is returned to the free-list and reused more than once.
+	for round := 0; round < 5; round++ {
+		for i := 0; i < 7; i++ {
+			q.Enqueue(round*10 + i)
+		}
+		for i := 0; i < 7; i++ {
+			val, err := q.Dequeue()
+			assert.NoError(t, err)
+			assert.Equal(t, round*10+i, val)
+		}
+	}
+	assert.Equal(t, 0, q.Size())
+}
+
+func TestChunkedQueuePeek(t *testing.T) {
+	q := kewpie.NewQueueWithChunkSize[string](2)
+	q.Enqueue("first")
+	q.Enqueue("second")
+
+	val, err := q.Peek()
+	assert.NoError(t, err)
+	assert.Equal(t, "first", val)
+	assert.Equal(t, 2, q.Size())
+}
+
+func TestChunkedQueueEnqueueBatchLargerThanChunkSize(t *testing.T) {
+	q := kewpie.NewQueueWithChunkSize[int](4)
+	items := make([]int, 17)
+	for i := range items {
+		items[i] = i
+	}
+
+	q.EnqueueBatch(items)
+	assert.Equal(t, len(items), q.Size())
+
+	batch, err := q.DequeueBatch(17)
+	assert.NoError(t, err)
+	assert.Equal(t, items, batch)
+}
+
+func TestNewQueueWithChunkSizeDefaultsWhenNonPositive(t *testing.T) {
+	q := kewpie.NewQueueWithChunkSize[int](0)
+	q.Enqueue(1)
+
+	val, err := q.Dequeue()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, val)
+}
@@ -26,8 +26,10 @@ func NewQueue[T any](sizes ...int) *Queue[T] {
 }
 
 // Enqueue adds an element of type T to the end of the queue.
-// TODO add soft limit (percentage) before resize is triggreed.
-// TODO If resize fails after soft limit, then go into degraded perf mode and warn.
+//
+// Queue itself always grows geometrically with no capacity limit. For a
+// soft/hard capacity limit with configurable backpressure policies and a
+// warning callback, see BoundedQueue.
 func (queue *Queue[T]) Enqueue(data T) {
 	if queue.size == len(queue.data) {
 		queue.resize(len(queue.data) * 2) // Double the size when full like a normal Go slice or map
@@ -119,6 +121,27 @@ func (queue *Queue[T]) Size() int {
 	return queue.size
 }
 
+// Iterate walks the queue head-to-tail without dequeuing, calling fn with
+// each element's index (0 at the head) and value. Iteration stops early if
+// fn returns false.
+func (queue *Queue[T]) Iterate(fn func(index int, v T) bool) {
+	for i := 0; i < queue.size; i++ {
+		if !fn(i, queue.data[(queue.head+i)%len(queue.data)]) {
+			return
+		}
+	}
+}
+
+// Snapshot returns a head-to-tail copy of the queue's elements without
+// dequeuing them.
+func (queue *Queue[T]) Snapshot() []T {
+	out := make([]T, queue.size)
+	for i := 0; i < queue.size; i++ {
+		out[i] = queue.data[(queue.head+i)%len(queue.data)]
+	}
+	return out
+}
+
 // Resize changes the size of the queue's data slice prioritising data integrity.
 func (queue *Queue[T]) resize(newCapacity int) {
 	// Attempt to allocate a new slice with the new capacity.
@@ -0,0 +1,73 @@
+package kewpie_test
+
+import (
+	"testing"
+
+	"github.com/mips171/kewpie"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIterate(t *testing.T) {
+	q := kewpie.NewQueue[int]()
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+
+	var visited []int
+	q.Iterate(func(index int, v int) bool {
+		visited = append(visited, v)
+		return true
+	})
+
+	assert.Equal(t, []int{1, 2, 3}, visited)
+	assert.Equal(t, 3, q.Size(), "Iterate must not drain the queue")
+}
+
+func TestIterateStopsEarly(t *testing.T) {
+	q := kewpie.NewQueue[int]()
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+
+	var visited []int
+	q.Iterate(func(index int, v int) bool {
+		visited = append(visited, v)
+		return index < 1
+	})
+
+	assert.Equal(t, []int{1, 2}, visited)
+}
+
+func TestIterateHonorsWrap(t *testing.T) {
+	q := kewpie.NewQueue[int](3)
+	q.Enqueue(1)
+	q.Enqueue(2)
+	_, _ = q.Dequeue() // advance head so tail wraps around the backing array
+	q.Enqueue(3)
+	q.Enqueue(4)
+
+	var visited []int
+	q.Iterate(func(index int, v int) bool {
+		visited = append(visited, v)
+		return true
+	})
+
+	assert.Equal(t, []int{2, 3, 4}, visited)
+}
+
+func TestSnapshot(t *testing.T) {
+	q := kewpie.NewQueue[int]()
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+
+	snap := q.Snapshot()
+	assert.Equal(t, []int{1, 2, 3}, snap)
+
+	// Mutating the snapshot must not affect the queue.
+	snap[0] = 99
+	val, err := q.Dequeue()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, val)
+}
@@ -0,0 +1,177 @@
+package kewpie
+
+import "errors"
+
+// PriorityQueue represents a generic priority queue backed by an array-based
+// binary heap. Ordering is determined by a user-supplied comparator rather
+// than the natural ordering of T, so PriorityQueue works for any type,
+// including ones with no natural ordering at all.
+type PriorityQueue[T any] struct {
+	data []T
+	size int
+	cmp  func(a, b T) int
+}
+
+// NewPriorityQueueWith creates a new PriorityQueue for elements of type T,
+// ordered by cmp. cmp should return a negative number if a has higher
+// priority than b, zero if they are equal, and a positive number if a has
+// lower priority than b (the same convention as cmp.Compare and
+// slices.SortFunc comparators).
+func NewPriorityQueueWith[T any](cmp func(a, b T) int, sizes ...int) *PriorityQueue[T] {
+	var size int
+	if len(sizes) > 0 {
+		size = sizes[0]
+	} else {
+		size = 1
+	}
+
+	if size <= 0 {
+		size = 1
+	}
+	return &PriorityQueue[T]{data: make([]T, size), size: 0, cmp: cmp}
+}
+
+// Enqueue adds an element of type T to the queue, sifting it up to restore
+// the heap invariant.
+func (pq *PriorityQueue[T]) Enqueue(data T) {
+	if pq.size == len(pq.data) {
+		pq.resize(max(len(pq.data)*2, 1))
+	}
+	pq.data[pq.size] = data
+	pq.size++
+	pq.siftUp(pq.size - 1)
+}
+
+// EnqueueBatch adds multiple elements of type T to the queue, minimising the
+// number of resize operations.
+func (pq *PriorityQueue[T]) EnqueueBatch(items []T) {
+	batchSize := len(items)
+	if batchSize == 0 {
+		return
+	}
+
+	requiredCapacity := pq.size + batchSize
+	currentCapacity := len(pq.data)
+	if requiredCapacity > currentCapacity {
+		newCapacity := max(currentCapacity, 1)
+		for newCapacity < requiredCapacity {
+			newCapacity *= 2
+		}
+		pq.resize(newCapacity)
+	}
+
+	for _, item := range items {
+		pq.data[pq.size] = item
+		pq.size++
+		pq.siftUp(pq.size - 1)
+	}
+}
+
+// Dequeue removes and returns the highest-priority element in the queue.
+// It returns an error if the queue is empty.
+func (pq *PriorityQueue[T]) Dequeue() (T, error) {
+	if pq.size == 0 {
+		var zero T
+		return zero, errors.New("kewpie: queue is empty")
+	}
+
+	top := pq.data[0]
+	var zero T
+	pq.size--
+	pq.data[0] = pq.data[pq.size]
+	pq.data[pq.size] = zero // Clearing the reference to avoid memory leak from stale struct
+	if pq.size > 0 {
+		pq.siftDown(0)
+	}
+
+	// shrink queue size if too large for current needs
+	if len(pq.data) > 1 && pq.size <= len(pq.data)/4 {
+		pq.resize(len(pq.data) / 2)
+	}
+
+	return top, nil
+}
+
+// DequeueBatch dequeues elements up to the specified batchSize, in priority
+// order.
+func (pq *PriorityQueue[T]) DequeueBatch(batchSize int) ([]T, error) {
+	var batch []T
+	for i := 0; i < batchSize; i++ {
+		if pq.size == 0 {
+			break
+		}
+		item, err := pq.Dequeue()
+		if err != nil {
+			return nil, err
+		}
+		batch = append(batch, item)
+	}
+	return batch, nil
+}
+
+// Peek returns the highest-priority element in the queue without removing
+// it. It returns an error if the queue is empty.
+func (pq *PriorityQueue[T]) Peek() (T, error) {
+	if pq.size == 0 {
+		var zero T
+		return zero, errors.New("kewpie: queue is empty")
+	}
+	return pq.data[0], nil
+}
+
+// Size returns the number of elements currently in the queue.
+func (pq *PriorityQueue[T]) Size() int {
+	return pq.size
+}
+
+// resize changes the size of the queue's backing array prioritising data
+// integrity, the same growth/shrink policy as Queue.resize.
+func (pq *PriorityQueue[T]) resize(newCapacity int) {
+	defer func() {
+		if err := recover(); err != nil {
+			// If we're here, allocation failed. Don't proceed with resizing.
+			return
+		}
+	}()
+
+	if newCapacity <= pq.size {
+		newCapacity = max(pq.size, 1)
+	}
+
+	newData := make([]T, newCapacity)
+	copy(newData, pq.data[:pq.size])
+	pq.data = newData
+}
+
+// siftUp restores the heap invariant by moving the element at index i
+// towards the root while it has higher priority than its parent.
+func (pq *PriorityQueue[T]) siftUp(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if pq.cmp(pq.data[i], pq.data[parent]) >= 0 {
+			break
+		}
+		pq.data[i], pq.data[parent] = pq.data[parent], pq.data[i]
+		i = parent
+	}
+}
+
+// siftDown restores the heap invariant by moving the element at index i
+// towards the leaves while it has lower priority than one of its children.
+func (pq *PriorityQueue[T]) siftDown(i int) {
+	for {
+		left, right := 2*i+1, 2*i+2
+		smallest := i
+		if left < pq.size && pq.cmp(pq.data[left], pq.data[smallest]) < 0 {
+			smallest = left
+		}
+		if right < pq.size && pq.cmp(pq.data[right], pq.data[smallest]) < 0 {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+		pq.data[i], pq.data[smallest] = pq.data[smallest], pq.data[i]
+		i = smallest
+	}
+}
@@ -0,0 +1,76 @@
+package kewpie_test
+
+import (
+	"testing"
+
+	"github.com/mips171/kewpie"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriorityQueueEnqueueDequeue(t *testing.T) {
+	pq := kewpie.NewPriorityQueueWith(func(a, b int) int { return a - b })
+	pq.Enqueue(5)
+	pq.Enqueue(1)
+	pq.Enqueue(3)
+
+	val, err := pq.Dequeue()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, val)
+
+	val, err = pq.Dequeue()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, val)
+
+	val, err = pq.Dequeue()
+	assert.NoError(t, err)
+	assert.Equal(t, 5, val)
+
+	_, err = pq.Dequeue()
+	assert.Error(t, err, "queue is empty")
+}
+
+func TestPriorityQueuePeek(t *testing.T) {
+	pq := kewpie.NewPriorityQueueWith(func(a, b string) int {
+		if a < b {
+			return -1
+		} else if a > b {
+			return 1
+		}
+		return 0
+	})
+	pq.Enqueue("banana")
+	pq.Enqueue("apple")
+
+	val, err := pq.Peek()
+	assert.NoError(t, err)
+	assert.Equal(t, "apple", val)
+	assert.Equal(t, 2, pq.Size())
+}
+
+func TestPriorityQueueEnqueueBatch(t *testing.T) {
+	pq := kewpie.NewPriorityQueueWith(func(a, b int) int { return b - a }) // max-heap
+	pq.EnqueueBatch([]int{3, 1, 4, 1, 5, 9, 2, 6})
+
+	assert.Equal(t, 8, pq.Size())
+
+	expected := []int{9, 6, 5, 4, 3, 2, 1, 1}
+	for _, want := range expected {
+		val, err := pq.Dequeue()
+		assert.NoError(t, err)
+		assert.Equal(t, want, val)
+	}
+}
+
+func TestPriorityQueueDequeueBatch(t *testing.T) {
+	pq := kewpie.NewPriorityQueueWith(func(a, b int) int { return a - b })
+	pq.EnqueueBatch([]int{4, 2, 3})
+
+	batch, err := pq.DequeueBatch(2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3}, batch)
+
+	batch, err = pq.DequeueBatch(2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{4}, batch)
+}